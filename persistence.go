@@ -0,0 +1,148 @@
+package ringbuffer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"fmt"
+	"io"
+)
+
+// magic identifies the wire format written by WriteTo and expected by Restore.
+var magic = [4]byte{'r', 'b', 'u', 'f'}
+
+// WriteTo serializes the buffer's elements, in logical oldest-to-newest order, to w
+// using enc to encode each element. The wire format is a 4-byte magic, the buffer's
+// capacity and length as uvarints, then each encoded element prefixed with its
+// length as a uvarint.
+func (r *RingBuffer[T]) WriteTo(w io.Writer, enc func(*T) ([]byte, error)) (int64, error) {
+	r.dataMx.Lock()
+	elements := r.ordered()
+	capacity := r.capacity
+	r.dataMx.Unlock()
+
+	var buf bytes.Buffer
+	buf.Write(magic[:])
+
+	var varintBuf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(varintBuf[:], uint64(capacity))
+	buf.Write(varintBuf[:n])
+	n = binary.PutUvarint(varintBuf[:], uint64(len(elements)))
+	buf.Write(varintBuf[:n])
+
+	for _, v := range elements {
+		encoded, err := enc(v)
+		if err != nil {
+			return 0, fmt.Errorf("ringbuffer: encode element: %w", err)
+		}
+		n = binary.PutUvarint(varintBuf[:], uint64(len(encoded)))
+		buf.Write(varintBuf[:n])
+		buf.Write(encoded)
+	}
+
+	written, err := w.Write(buf.Bytes())
+	return int64(written), err
+}
+
+// Restore replaces the buffer's contents with elements decoded from r using dec,
+// in the wire format written by WriteTo. If the stream's capacity differs from the
+// receiver's, the receiver is resized to match before the elements are loaded.
+func (r *RingBuffer[T]) Restore(rd io.Reader, dec func([]byte) (*T, error)) (int64, error) {
+	cr := &countingReader{r: rd}
+
+	var gotMagic [4]byte
+	if _, err := io.ReadFull(cr, gotMagic[:]); err != nil {
+		return cr.n, fmt.Errorf("ringbuffer: read magic: %w", err)
+	}
+	if gotMagic != magic {
+		return cr.n, fmt.Errorf("ringbuffer: invalid magic %q", gotMagic)
+	}
+
+	capacity, err := binary.ReadUvarint(cr)
+	if err != nil {
+		return cr.n, fmt.Errorf("ringbuffer: read capacity: %w", err)
+	}
+	length, err := binary.ReadUvarint(cr)
+	if err != nil {
+		return cr.n, fmt.Errorf("ringbuffer: read length: %w", err)
+	}
+
+	elements := make([]*T, 0, length)
+	for i := uint64(0); i < length; i++ {
+		size, err := binary.ReadUvarint(cr)
+		if err != nil {
+			return cr.n, fmt.Errorf("ringbuffer: read element size: %w", err)
+		}
+		encoded := make([]byte, size)
+		if _, err := io.ReadFull(cr, encoded); err != nil {
+			return cr.n, fmt.Errorf("ringbuffer: read element: %w", err)
+		}
+		v, err := dec(encoded)
+		if err != nil {
+			return cr.n, fmt.Errorf("ringbuffer: decode element: %w", err)
+		}
+		elements = append(elements, v)
+	}
+
+	r.dataMx.Lock()
+	defer r.dataMx.Unlock()
+
+	if uint(capacity) != r.capacity {
+		r.resize(uint(capacity))
+	}
+	r.head = 0
+	r.tail = 0
+	for i := range r.data {
+		r.data[i] = nil
+	}
+	for _, v := range elements {
+		r.push(v)
+	}
+
+	return cr.n, nil
+}
+
+// countingReader wraps an io.Reader to track bytes consumed and to supply the
+// io.ByteReader that binary.ReadUvarint requires.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (c *countingReader) ReadByte() (byte, error) {
+	var b [1]byte
+	if _, err := io.ReadFull(c.r, b[:]); err != nil {
+		return 0, err
+	}
+	c.n++
+	return b[0], nil
+}
+
+// Save writes rb's elements to w, encoding each one with encoding/gob.
+func Save[T any](rb *RingBuffer[T], w io.Writer) (int64, error) {
+	return rb.WriteTo(w, func(v *T) ([]byte, error) {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	})
+}
+
+// Load replaces rb's contents with elements read from r, decoding each one with
+// encoding/gob.
+func Load[T any](rb *RingBuffer[T], r io.Reader) (int64, error) {
+	return rb.Restore(r, func(encoded []byte) (*T, error) {
+		var v T
+		if err := gob.NewDecoder(bytes.NewReader(encoded)).Decode(&v); err != nil {
+			return nil, err
+		}
+		return &v, nil
+	})
+}
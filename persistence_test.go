@@ -0,0 +1,61 @@
+package ringbuffer
+
+import (
+	"bytes"
+	"testing"
+)
+
+func Test_ringBuffer_SaveLoad(t *testing.T) {
+	ring := New[string](5, 1)
+	defer ring.Close()
+
+	values := []string{"a", "b", "c"}
+	pointerValueSlice := make([]*string, len(values))
+	for i, v := range values {
+		s := v
+		pointerValueSlice[i] = &s
+	}
+	ring.PushAndWait(pointerValueSlice...)
+
+	var buf bytes.Buffer
+	if _, err := Save(ring, &buf); err != nil {
+		t.Fatalf("Save() error = %v, want nil", err)
+	}
+
+	restored := New[string](1, 1)
+	defer restored.Close()
+
+	if _, err := Load(restored, &buf); err != nil {
+		t.Fatalf("Load() error = %v, want nil", err)
+	}
+
+	var got []string
+	for v := range restored.Iterate() {
+		got = append(got, *v)
+	}
+	want := []string{"a", "b", "c"}
+	for i, v := range got {
+		if v != want[i] {
+			t.Errorf("restored element %d = %v, want %v", i, v, want[i])
+		}
+	}
+	if len(got) != len(want) {
+		t.Fatalf("restored %d elements, want %d", len(got), len(want))
+	}
+	if restored.capacity != ring.capacity {
+		t.Errorf("restored capacity = %d, want %d", restored.capacity, ring.capacity)
+	}
+}
+
+func Test_ringBuffer_Restore_invalidMagic(t *testing.T) {
+	ring := New[string](5, 1)
+	defer ring.Close()
+
+	_, err := ring.Restore(bytes.NewReader([]byte("not a ringbuffer stream")), func(b []byte) (*string, error) {
+		s := string(b)
+		return &s, nil
+	})
+	if err == nil {
+		t.Fatal("Restore() error = nil, want non-nil for invalid magic")
+	}
+}
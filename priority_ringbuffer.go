@@ -0,0 +1,177 @@
+package ringbuffer
+
+import (
+	"cmp"
+	"sync"
+)
+
+// PriorityRingBuffer is a bounded priority queue backed by a fixed-capacity binary
+// heap. Unlike RingBuffer, which evicts the oldest element when full, it evicts the
+// lowest-priority element. It reuses the same async push queue and idle-hook
+// machinery as RingBuffer, so PushAndWait, Wait, Close and Flush behave the same way.
+type PriorityRingBuffer[T any, P cmp.Ordered] struct {
+	*pushLoop[priorityItem[T, P]]
+	dataMx   sync.Mutex
+	capacity uint
+	data     []priorityItem[T, P]
+}
+
+type priorityItem[T any, P cmp.Ordered] struct {
+	value    *T
+	priority P
+}
+
+// NewPriorityRingBuffer creates a new priority ring buffer of type T with the
+// specified capacity and push buffer capacity. See New for the semantics of
+// pushBufferSize.
+func NewPriorityRingBuffer[T any, P cmp.Ordered](capacity uint, pushBufferSize uint) *PriorityRingBuffer[T, P] {
+	ring := &PriorityRingBuffer[T, P]{
+		capacity: capacity,
+		data:     make([]priorityItem[T, P], 0, capacity),
+	}
+	ring.pushLoop = newPushLoop[priorityItem[T, P]](pushBufferSize, &ring.dataMx, ring.push)
+
+	return ring
+}
+
+// push inserts item into the heap. If the buffer is at capacity, it replaces the
+// current lowest-priority element if item outranks it, and drops item otherwise.
+func (r *PriorityRingBuffer[T, P]) push(item priorityItem[T, P]) {
+	if uint(len(r.data)) < r.capacity {
+		r.data = append(r.data, item)
+		r.siftUp(len(r.data) - 1)
+		return
+	}
+	if len(r.data) == 0 {
+		return
+	}
+	minIdx := r.minIndex()
+	if item.priority <= r.data[minIdx].priority {
+		return
+	}
+	r.data[minIdx] = item
+	r.siftUp(minIdx)
+}
+
+// minIndex returns the index of the lowest-priority element. In a max-heap the
+// minimum is always among the leaves, so only those need to be examined.
+func (r *PriorityRingBuffer[T, P]) minIndex() int {
+	n := len(r.data)
+	minIdx := n / 2
+	for i := minIdx + 1; i < n; i++ {
+		if r.data[i].priority < r.data[minIdx].priority {
+			minIdx = i
+		}
+	}
+	return minIdx
+}
+
+func (r *PriorityRingBuffer[T, P]) siftUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if r.data[parent].priority >= r.data[i].priority {
+			break
+		}
+		r.data[parent], r.data[i] = r.data[i], r.data[parent]
+		i = parent
+	}
+}
+
+func (r *PriorityRingBuffer[T, P]) siftDown(i int) {
+	n := len(r.data)
+	for {
+		left, right := 2*i+1, 2*i+2
+		largest := i
+		if left < n && r.data[left].priority > r.data[largest].priority {
+			largest = left
+		}
+		if right < n && r.data[right].priority > r.data[largest].priority {
+			largest = right
+		}
+		if largest == i {
+			break
+		}
+		r.data[i], r.data[largest] = r.data[largest], r.data[i]
+		i = largest
+	}
+}
+
+// Push queues v with priority prio for insertion into the heap.
+func (r *PriorityRingBuffer[T, P]) Push(v *T, prio P) {
+	if r.closed {
+		panic("attempted to push to closed ring buffer")
+	}
+	r.pushQueue <- priorityItem[T, P]{value: v, priority: prio}
+}
+
+func (r *PriorityRingBuffer[T, P]) popMax() (*T, P, bool) {
+	if len(r.data) == 0 {
+		var zero P
+		return nil, zero, false
+	}
+	top := r.data[0]
+	last := len(r.data) - 1
+	r.data[0] = r.data[last]
+	r.data = r.data[:last]
+	if len(r.data) > 0 {
+		r.siftDown(0)
+	}
+	return top.value, top.priority, true
+}
+
+// PopMax removes and returns the highest-priority element in the buffer.
+func (r *PriorityRingBuffer[T, P]) PopMax() (*T, P, bool) {
+	r.dataMx.Lock()
+	defer r.dataMx.Unlock()
+	return r.popMax()
+}
+
+// Peek returns the highest-priority element without removing it.
+func (r *PriorityRingBuffer[T, P]) Peek() (*T, P, bool) {
+	r.dataMx.Lock()
+	defer r.dataMx.Unlock()
+	if len(r.data) == 0 {
+		var zero P
+		return nil, zero, false
+	}
+	top := r.data[0]
+	return top.value, top.priority, true
+}
+
+func (r *PriorityRingBuffer[T, P]) Len() uint {
+	return uint(len(r.data))
+}
+
+func (r *PriorityRingBuffer[T, P]) Flush() []*T {
+	var result []*T
+	for {
+		v, _, ok := r.PopMax()
+		if !ok {
+			break
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+func (r *PriorityRingBuffer[T, P]) FlushAndClose() []*T {
+	r.Close()
+	return r.Flush()
+}
+
+// PushAndWait pushes v with priority prio and blocks execution until the ring has
+// acknowledged the push. Keep in mind that this will not only block until the value
+// passed has been pushed, it will potentially block until other values coming from
+// other goroutines have been pushed as well.
+func (r *PriorityRingBuffer[T, P]) PushAndWait(v *T, prio P) {
+	if r.closed {
+		panic("attempted to push to closed ring buffer")
+	}
+	r.Push(v, prio)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	r.AddQueueIdleHook(func() {
+		wg.Done()
+	})
+	wg.Wait()
+}
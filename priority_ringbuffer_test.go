@@ -0,0 +1,85 @@
+package ringbuffer
+
+import "testing"
+
+func Test_priorityRingBuffer_PopMax(t *testing.T) {
+	ring := NewPriorityRingBuffer[string, int](3, 1)
+	defer ring.Close()
+
+	a, b, c := "a", "b", "c"
+	ring.PushAndWait(&a, 1)
+	ring.PushAndWait(&b, 3)
+	ring.PushAndWait(&c, 2)
+
+	v, prio, ok := ring.PopMax()
+	if !ok || *v != b || prio != 3 {
+		t.Fatalf("PopMax() = (%v, %v, %v), want (%v, 3, true)", v, prio, ok, b)
+	}
+
+	v, prio, ok = ring.PopMax()
+	if !ok || *v != c || prio != 2 {
+		t.Fatalf("PopMax() = (%v, %v, %v), want (%v, 2, true)", v, prio, ok, c)
+	}
+
+	v, prio, ok = ring.PopMax()
+	if !ok || *v != a || prio != 1 {
+		t.Fatalf("PopMax() = (%v, %v, %v), want (%v, 1, true)", v, prio, ok, a)
+	}
+
+	if _, _, ok := ring.PopMax(); ok {
+		t.Fatal("PopMax() on empty buffer = true, want false")
+	}
+}
+
+func Test_priorityRingBuffer_evictsLowestPriorityWhenFull(t *testing.T) {
+	ring := NewPriorityRingBuffer[string, int](2, 1)
+	defer ring.Close()
+
+	a, b, c := "a", "b", "c"
+	ring.PushAndWait(&a, 1)
+	ring.PushAndWait(&b, 2)
+	// c outranks the lowest-priority element (a, priority 1), so it replaces it.
+	ring.PushAndWait(&c, 3)
+
+	if got := ring.Len(); got != 2 {
+		t.Fatalf("Len() = %d, want 2", got)
+	}
+
+	v, prio, ok := ring.PopMax()
+	if !ok || *v != c || prio != 3 {
+		t.Fatalf("PopMax() = (%v, %v, %v), want (%v, 3, true)", v, prio, ok, c)
+	}
+
+	v, prio, ok = ring.PopMax()
+	if !ok || *v != b || prio != 2 {
+		t.Fatalf("PopMax() = (%v, %v, %v), want (%v, 2, true)", v, prio, ok, b)
+	}
+}
+
+func Test_priorityRingBuffer_dropsLowerPriorityPushWhenFull(t *testing.T) {
+	ring := NewPriorityRingBuffer[string, int](2, 1)
+	defer ring.Close()
+
+	a, b, c := "a", "b", "c"
+	ring.PushAndWait(&a, 2)
+	ring.PushAndWait(&b, 3)
+	// c does not outrank the lowest-priority element (a, priority 2), so it is dropped.
+	ring.PushAndWait(&c, 1)
+
+	if got := ring.Len(); got != 2 {
+		t.Fatalf("Len() = %d, want 2", got)
+	}
+
+	_, _, ok := ring.Peek()
+	if !ok {
+		t.Fatal("Peek() ok = false, want true")
+	}
+	got := ring.Flush()
+	if len(got) != 2 || *got[0] != b || *got[1] != a {
+		gotValues := make([]string, len(got))
+		for i, v := range got {
+			gotValues[i] = *v
+		}
+		t.Fatalf("Flush() = %v, want [b a]", gotValues)
+	}
+}
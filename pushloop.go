@@ -0,0 +1,90 @@
+package ringbuffer
+
+import "sync"
+
+// pushLoop holds the async push-queue and idle-hook machinery shared by
+// RingBuffer and PriorityRingBuffer. Pushes enqueued on pushQueue are applied,
+// one at a time and under dataMx, by a single background goroutine; that
+// goroutine also drives the idle hooks used by PushAndWait and Wait, and the
+// close handshake used by Close and FlushAndClose.
+type pushLoop[I any] struct {
+	pushQueue   chan I
+	closeBuffer chan struct{}
+	closed      bool
+	waiterMx    sync.Mutex
+	waiters     []func()
+}
+
+// newPushLoop starts the background goroutine that applies queued items with
+// apply, under dataMx, until Close is called. bufferSize is the capacity of
+// the push queue, as in New.
+func newPushLoop[I any](bufferSize uint, dataMx *sync.Mutex, apply func(I)) *pushLoop[I] {
+	pl := &pushLoop[I]{
+		pushQueue:   make(chan I, bufferSize),
+		closeBuffer: make(chan struct{}),
+	}
+
+	go func() {
+		for {
+			select {
+			case v := <-pl.pushQueue:
+				dataMx.Lock()
+				apply(v)
+				dataMx.Unlock()
+			default:
+				select {
+				case <-pl.closeBuffer:
+					close(pl.pushQueue)
+					close(pl.closeBuffer)
+					pl.closed = true
+					return
+				default:
+					pl.waiterMx.Lock()
+					if len(pl.waiters) > 0 {
+						for _, waiter := range pl.waiters {
+							waiter()
+						}
+					}
+					pl.waiters = nil
+					pl.waiterMx.Unlock()
+				}
+
+			}
+		}
+	}()
+
+	return pl
+}
+
+func (pl *pushLoop[I]) Close() {
+	if pl.closed {
+		panic("attempted to close already closed ring buffer")
+	}
+	pl.closeBuffer <- struct{}{}
+}
+
+func (pl *pushLoop[I]) IsClosed() bool {
+	return pl.closed
+}
+
+// AddQueueIdleHook adds a function to be called when the ring buffer push queue is idle.
+func (pl *pushLoop[I]) AddQueueIdleHook(f func()) {
+	if pl.closed {
+		panic("attempted to execute on closed ring buffer")
+	}
+	pl.waiterMx.Lock()
+	pl.waiters = append(pl.waiters, f)
+	pl.waiterMx.Unlock()
+}
+
+func (pl *pushLoop[I]) Wait() {
+	if pl.closed {
+		panic("attempted to wait on closed ring buffer")
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	pl.AddQueueIdleHook(func() {
+		wg.Done()
+	})
+	wg.Wait()
+}
@@ -1,24 +1,27 @@
 package ringbuffer
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"time"
 )
 
 // RingBuffer is the main struct of the package.
 // It's only exported so that it can be referenced in field and variable declarations.
 // To create a new RingBuffer, use the New function.
 type RingBuffer[T any] struct {
-	dataMx      sync.Mutex
-	pushQueue   chan *T
-	capacity    uint
-	head        uint
-	tail        uint
-	data        []*T
-	closeBuffer chan struct{}
-	closed      bool
-	waiterMx    sync.Mutex
-	waiters     []func()
+	*pushLoop[*T]
+	dataMx       sync.Mutex
+	capacity     uint
+	head         uint
+	tail         uint
+	data         []*T
+	unbounded    bool
+	growthFactor float64
+	maxCapacity  uint
+	notEmpty     *sync.Cond
+	pushSeq      uint64
 }
 
 // New creates a new ring buffer of type T with the specified capacity and push buffer capacity.
@@ -27,51 +30,72 @@ type RingBuffer[T any] struct {
 // Those operations are buffered so that Push calls do not block execution,
 // pushBufferSize is the maximum number of push operations that can be buffered.
 func New[T any](capacity uint, pushBufferSize uint) *RingBuffer[T] {
-	ring := &RingBuffer[T]{
-		pushQueue:   make(chan *T, pushBufferSize),
-		capacity:    capacity,
-		data:        make([]*T, capacity),
-		closeBuffer: make(chan struct{}),
-	}
-
-	go func() {
-		for {
-			select {
-			case v := <-ring.pushQueue:
-				ring.dataMx.Lock()
-				ring.push(v)
-				ring.dataMx.Unlock()
-			default:
-				select {
-				case <-ring.closeBuffer:
-					close(ring.pushQueue)
-					close(ring.closeBuffer)
-					ring.closed = true
-					return
-				default:
-					ring.waiterMx.Lock()
-					if len(ring.waiters) > 0 {
-						for _, waiter := range ring.waiters {
-							waiter()
-						}
-					}
-					ring.waiters = nil
-					ring.waiterMx.Unlock()
-				}
+	return newRingBuffer[T](capacity, pushBufferSize)
+}
 
-			}
-		}
-	}()
+// NewUnbounded creates a new ring buffer of type T that grows instead of overwriting
+// its oldest elements whenever a push would otherwise do so.
+// initialCapacity and pushBufferSize behave as in New.
+// Whenever the buffer is full, its backing storage is reallocated at
+// capacity*growthFactor, capped at maxCapacity (or unlimited growth if maxCapacity is 0).
+// Once maxCapacity is reached, the buffer falls back to overwriting its oldest elements.
+// NewUnbounded panics if growthFactor is not greater than 1, since anything else
+// would never grow the buffer (or wouldn't grow it reliably, in the case of NaN).
+func NewUnbounded[T any](initialCapacity, pushBufferSize uint, growthFactor float64, maxCapacity uint) *RingBuffer[T] {
+	if !(growthFactor > 1) {
+		panic("ringbuffer: growthFactor must be greater than 1")
+	}
+	ring := newRingBuffer[T](initialCapacity, pushBufferSize)
+	ring.unbounded = true
+	ring.growthFactor = growthFactor
+	ring.maxCapacity = maxCapacity
+	return ring
+}
+
+func newRingBuffer[T any](capacity uint, pushBufferSize uint) *RingBuffer[T] {
+	ring := &RingBuffer[T]{
+		capacity: capacity,
+		data:     make([]*T, capacity),
+	}
+	ring.notEmpty = sync.NewCond(&ring.dataMx)
+	ring.pushLoop = newPushLoop[*T](pushBufferSize, &ring.dataMx, ring.push)
 
 	return ring
 }
 
 func (r *RingBuffer[T]) push(v *T) {
+	if r.unbounded && r.full() {
+		r.grow()
+	}
 	r.data[r.head] = v
 	r.head = (r.head + 1) % r.capacity
 	if r.head == r.tail {
 		r.tail = (r.tail + 1) % r.capacity
 	}
+	r.pushSeq++
+	r.notEmpty.Broadcast()
+}
+
+// full reports whether the next push would overwrite the tail element.
+func (r *RingBuffer[T]) full() bool {
+	return (r.head+1)%r.capacity == r.tail
+}
+
+// grow reallocates the backing storage to a larger capacity, as dictated by
+// growthFactor and maxCapacity. If maxCapacity has already been reached, it is a no-op
+// and the caller falls back to the regular overwrite-oldest behavior.
+func (r *RingBuffer[T]) grow() {
+	newCapacity := uint(float64(r.capacity) * r.growthFactor)
+	if newCapacity <= r.capacity {
+		newCapacity = r.capacity + 1
+	}
+	if r.maxCapacity > 0 && newCapacity > r.maxCapacity {
+		newCapacity = r.maxCapacity
+	}
+	if newCapacity <= r.capacity {
+		return
+	}
+	r.resize(newCapacity)
 }
 
 func (r *RingBuffer[T]) Push(v ...*T) {
@@ -98,6 +122,84 @@ func (r *RingBuffer[T]) Pop() (*T, bool) {
 	return r.pop()
 }
 
+// PopBlocking pops the oldest element from the ring, blocking until one is available,
+// ctx is done, or ctx's deadline is exceeded. On success it returns (v, nil);
+// otherwise it returns (nil, ctx.Err()).
+func (r *RingBuffer[T]) PopBlocking(ctx context.Context) (*T, error) {
+	r.dataMx.Lock()
+	defer r.dataMx.Unlock()
+
+	stop := context.AfterFunc(ctx, func() {
+		r.dataMx.Lock()
+		r.notEmpty.Broadcast()
+		r.dataMx.Unlock()
+	})
+	defer stop()
+
+	for {
+		if v, ok := r.pop(); ok {
+			return v, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		r.notEmpty.Wait()
+	}
+}
+
+// PopWithDeadline pops the oldest element from the ring, blocking until one is
+// available or d passes, in which case it returns (nil, context.DeadlineExceeded).
+func (r *RingBuffer[T]) PopWithDeadline(d time.Time) (*T, error) {
+	ctx, cancel := context.WithDeadline(context.Background(), d)
+	defer cancel()
+	return r.PopBlocking(ctx)
+}
+
+// PopBatch pops up to max elements from the ring in a single lock acquisition,
+// blocking until at least one element is available, ctx is done, or ctx's deadline
+// is exceeded. It returns early, with fewer than max elements, once the ring runs
+// out of elements to pop. This amortizes lock cost for high-throughput consumers
+// that would otherwise call Pop in a tight loop.
+//
+// If max <= 0, PopBatch returns immediately with a nil batch and no error.
+func (r *RingBuffer[T]) PopBatch(ctx context.Context, max int) ([]*T, error) {
+	if max <= 0 {
+		return nil, nil
+	}
+
+	r.dataMx.Lock()
+	defer r.dataMx.Unlock()
+
+	stop := context.AfterFunc(ctx, func() {
+		r.dataMx.Lock()
+		r.notEmpty.Broadcast()
+		r.dataMx.Unlock()
+	})
+	defer stop()
+
+	for {
+		v, ok := r.pop()
+		if !ok {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			r.notEmpty.Wait()
+			continue
+		}
+
+		batch := make([]*T, 0, max)
+		batch = append(batch, v)
+		for len(batch) < max {
+			v, ok := r.pop()
+			if !ok {
+				break
+			}
+			batch = append(batch, v)
+		}
+		return batch, nil
+	}
+}
+
 func (r *RingBuffer[T]) Len() uint {
 	if r.head >= r.tail {
 		return r.head - r.tail
@@ -122,31 +224,107 @@ func (r *RingBuffer[T]) Flush() []*T {
 	return result
 }
 
-// TODO: add resize
+// Resize changes the capacity of the ring buffer to newCapacity, preserving the
+// existing elements in logical order. Since the ring always keeps one slot free,
+// it holds at most newCapacity-1 elements afterwards: if Len() exceeds that, the
+// oldest elements are dropped so that the remaining ones fit.
+func (r *RingBuffer[T]) Resize(newCapacity uint) {
+	r.dataMx.Lock()
+	defer r.dataMx.Unlock()
+	r.resize(newCapacity)
+}
+
+// resize reallocates the backing storage to newCapacity, copying the existing
+// elements in logical order starting from tail. Callers must hold dataMx.
+// newCapacity is clamped to a minimum of 1, since the ring always reserves one
+// slot to distinguish full from empty; a zero-length backing array would make
+// the very next push index out of range.
+func (r *RingBuffer[T]) resize(newCapacity uint) {
+	if newCapacity == 0 {
+		newCapacity = 1
+	}
+
+	elements := r.ordered()
+	usable := newCapacity
+	if usable > 0 {
+		usable--
+	}
+	if uint(len(elements)) > usable {
+		elements = elements[uint(len(elements))-usable:]
+	}
+	newData := make([]*T, newCapacity)
+	copy(newData, elements)
+	r.data = newData
+	r.capacity = newCapacity
+	r.tail = 0
+	r.head = uint(len(elements))
+}
+
+// ordered returns the buffer's elements in logical oldest-to-newest order.
+// Callers must hold dataMx.
+func (r *RingBuffer[T]) ordered() []*T {
+	out := make([]*T, 0, r.Len())
+	for i := r.tail; i != r.head; i = (i + 1) % r.capacity {
+		out = append(out, r.data[i])
+	}
+	return out
+}
+
+// Snapshot returns a copy of the buffer's elements in logical oldest-to-newest
+// order, taken atomically under dataMx. Because the result is a copy, it remains
+// consistent even if the ring is concurrently pushed to, popped from or resized.
+func (r *RingBuffer[T]) Snapshot() []*T {
+	r.dataMx.Lock()
+	defer r.dataMx.Unlock()
+	return r.ordered()
+}
+
+// ForEach calls f with each element in the buffer, from oldest to newest, holding
+// dataMx for the duration. If f returns false, iteration stops early.
+func (r *RingBuffer[T]) ForEach(f func(*T) bool) {
+	r.dataMx.Lock()
+	defer r.dataMx.Unlock()
+	for i := r.tail; i != r.head; i = (i + 1) % r.capacity {
+		if !f(r.data[i]) {
+			return
+		}
+	}
+}
+
+// ForEachReverse calls f with each element in the buffer, from newest to oldest,
+// holding dataMx for the duration. If f returns false, iteration stops early.
+func (r *RingBuffer[T]) ForEachReverse(f func(*T) bool) {
+	r.dataMx.Lock()
+	defer r.dataMx.Unlock()
+	elements := r.ordered()
+	for i := len(elements) - 1; i >= 0; i-- {
+		if !f(elements[i]) {
+			return
+		}
+	}
+}
 
 // Iterate over the ring buffer in order, from oldest to newest.
 // Without popping any elements from the ring.
 func (r *RingBuffer[T]) Iterate() <-chan *T {
-	ch := make(chan *T)
-	go func() {
-		for i := r.tail; i != r.head; i = (i + 1) % r.capacity {
-			ch <- r.data[i]
-		}
-		close(ch)
-	}()
+	elements := r.Snapshot()
+	ch := make(chan *T, len(elements))
+	for _, v := range elements {
+		ch <- v
+	}
+	close(ch)
 	return ch
 }
 
 // IterateReverse iterates over the ring buffer in reverse order, from newest to oldest.
 // Without popping any elements from the ring.
 func (r *RingBuffer[T]) IterateReverse() <-chan *T {
-	ch := make(chan *T)
-	go func() {
-		for i := r.head; i != r.tail; i = (i + r.capacity - 1) % r.capacity {
-			ch <- r.data[i]
-		}
-		close(ch)
-	}()
+	elements := r.Snapshot()
+	ch := make(chan *T, len(elements))
+	for i := len(elements) - 1; i >= 0; i-- {
+		ch <- elements[i]
+	}
+	close(ch)
 	return ch
 }
 
@@ -154,32 +332,11 @@ func (r *RingBuffer[T]) String() string {
 	return fmt.Sprintf("ringBuffer{capacity: %d, head: %d, tail: %d, data: %v}", r.capacity, r.head, r.tail, r.data)
 }
 
-func (r *RingBuffer[T]) Close() {
-	if r.closed {
-		panic("attempted to close already closed ring buffer")
-	}
-	r.closeBuffer <- struct{}{}
-}
-
 func (r *RingBuffer[T]) FlushAndClose() []*T {
 	r.Close()
 	return r.Flush()
 }
 
-func (r *RingBuffer[T]) IsClosed() bool {
-	return r.closed
-}
-
-// AddQueueIdleHook adds a function to be called when the ring buffer push queue is idle.
-func (r *RingBuffer[T]) AddQueueIdleHook(f func()) {
-	if r.closed {
-		panic("attempted to execute on closed ring buffer")
-	}
-	r.waiterMx.Lock()
-	r.waiters = append(r.waiters, f)
-	r.waiterMx.Unlock()
-}
-
 // PushAndWait pushes the given value to the ring and blocks execution until the ring has acknowledged the push.
 // Keep in mind that this will not only block until the values passed have been pushed,
 // it will potentially block until other values coming from other goroutines have been pushed as well.
@@ -195,15 +352,3 @@ func (r *RingBuffer[T]) PushAndWait(v ...*T) {
 	})
 	wg.Wait()
 }
-
-func (r *RingBuffer[T]) Wait() {
-	if r.closed {
-		panic("attempted to wait on closed ring buffer")
-	}
-	var wg sync.WaitGroup
-	wg.Add(1)
-	r.AddQueueIdleHook(func() {
-		wg.Done()
-	})
-	wg.Wait()
-}
@@ -1,8 +1,12 @@
 package ringbuffer
 
 import (
+	"context"
+	"errors"
+	"math"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func Test_ringBuffer_Push(t *testing.T) {
@@ -73,3 +77,247 @@ func Test_ringBuffer_Push(t *testing.T) {
 		})
 	}
 }
+
+func Test_ringBuffer_NewUnbounded_grows(t *testing.T) {
+	ring := NewUnbounded[string](2, 1, 2, 0)
+	defer ring.Close()
+
+	values := []string{"a", "b", "c", "d", "e"}
+	pointerValueSlice := make([]*string, len(values))
+	for i, v := range values {
+		s := v
+		pointerValueSlice[i] = &s
+	}
+	ring.PushAndWait(pointerValueSlice...)
+
+	if ring.capacity <= 2 {
+		t.Errorf("ringBuffer.capacity = %d, want > 2", ring.capacity)
+	}
+
+	var got []string
+	for v := range ring.Iterate() {
+		got = append(got, *v)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("ringBuffer.Iterate() = %v, want %v", got, values)
+	}
+}
+
+func Test_ringBuffer_PopBlocking(t *testing.T) {
+	ring := New[string](3, 1)
+	defer ring.Close()
+
+	done := make(chan struct{})
+	var got *string
+	var err error
+	go func() {
+		got, err = ring.PopBlocking(context.Background())
+		close(done)
+	}()
+
+	v := "a"
+	ring.PushAndWait(&v)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("PopBlocking() did not unblock after a push")
+	}
+	if err != nil {
+		t.Errorf("PopBlocking() error = %v, want nil", err)
+	}
+	if got == nil || *got != v {
+		t.Errorf("PopBlocking() = %v, want %v", got, v)
+	}
+}
+
+func Test_ringBuffer_PopBlocking_ctxCancel(t *testing.T) {
+	ring := New[string](3, 1)
+	defer ring.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ring.PopBlocking(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("PopBlocking() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func Test_ringBuffer_PopWithDeadline_timesOut(t *testing.T) {
+	ring := New[string](3, 1)
+	defer ring.Close()
+
+	_, err := ring.PopWithDeadline(time.Now().Add(10 * time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("PopWithDeadline() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func Test_ringBuffer_PopBatch(t *testing.T) {
+	ring := New[string](5, 1)
+	defer ring.Close()
+
+	values := []string{"a", "b", "c"}
+	pointerValueSlice := make([]*string, len(values))
+	for i, v := range values {
+		s := v
+		pointerValueSlice[i] = &s
+	}
+	ring.PushAndWait(pointerValueSlice...)
+
+	batch, err := ring.PopBatch(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("PopBatch() error = %v, want nil", err)
+	}
+	var got []string
+	for _, v := range batch {
+		got = append(got, *v)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("PopBatch() = %v, want %v", got, values)
+	}
+}
+
+func Test_ringBuffer_PopBatch_nonPositiveMax(t *testing.T) {
+	ring := New[string](5, 1)
+	defer ring.Close()
+
+	for _, max := range []int{0, -1} {
+		batch, err := ring.PopBatch(context.Background(), max)
+		if err != nil {
+			t.Fatalf("PopBatch(%d) error = %v, want nil", max, err)
+		}
+		if batch != nil {
+			t.Errorf("PopBatch(%d) = %v, want nil", max, batch)
+		}
+	}
+}
+
+func Test_ringBuffer_Snapshot(t *testing.T) {
+	ring := New[string](5, 1)
+	defer ring.Close()
+
+	values := []string{"a", "b", "c"}
+	pointerValueSlice := make([]*string, len(values))
+	for i, v := range values {
+		s := v
+		pointerValueSlice[i] = &s
+	}
+	ring.PushAndWait(pointerValueSlice...)
+
+	snapshot := ring.Snapshot()
+	var got []string
+	for _, v := range snapshot {
+		got = append(got, *v)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("Snapshot() = %v, want %v", got, values)
+	}
+}
+
+func Test_ringBuffer_ForEachReverse(t *testing.T) {
+	ring := New[string](5, 1)
+	defer ring.Close()
+
+	values := []string{"a", "b", "c"}
+	pointerValueSlice := make([]*string, len(values))
+	for i, v := range values {
+		s := v
+		pointerValueSlice[i] = &s
+	}
+	ring.PushAndWait(pointerValueSlice...)
+
+	var got []string
+	ring.ForEachReverse(func(v *string) bool {
+		got = append(got, *v)
+		return true
+	})
+	want := []string{"c", "b", "a"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ForEachReverse() visited %v, want %v", got, want)
+	}
+}
+
+func Test_ringBuffer_ForEach_stopsEarly(t *testing.T) {
+	ring := New[string](5, 1)
+	defer ring.Close()
+
+	values := []string{"a", "b", "c"}
+	pointerValueSlice := make([]*string, len(values))
+	for i, v := range values {
+		s := v
+		pointerValueSlice[i] = &s
+	}
+	ring.PushAndWait(pointerValueSlice...)
+
+	var got []string
+	ring.ForEach(func(v *string) bool {
+		got = append(got, *v)
+		return *v != "b"
+	})
+	want := []string{"a", "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ForEach() visited %v, want %v", got, want)
+	}
+}
+
+func Test_ringBuffer_Resize(t *testing.T) {
+	ring := New[string](5, 1)
+	defer ring.Close()
+
+	values := []string{"a", "b", "c"}
+	pointerValueSlice := make([]*string, len(values))
+	for i, v := range values {
+		s := v
+		pointerValueSlice[i] = &s
+	}
+	ring.PushAndWait(pointerValueSlice...)
+
+	ring.Resize(3)
+
+	var got []string
+	for v := range ring.Iterate() {
+		got = append(got, *v)
+	}
+	want := []string{"b", "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ringBuffer.Iterate() after Resize() = %v, want %v", got, want)
+	}
+}
+
+func Test_ringBuffer_Resize_zeroClampsToOne(t *testing.T) {
+	ring := New[string](5, 1)
+	defer ring.Close()
+
+	v := "a"
+	ring.PushAndWait(&v)
+
+	ring.Resize(0)
+
+	if ring.capacity != 1 {
+		t.Fatalf("ringBuffer.capacity after Resize(0) = %d, want 1", ring.capacity)
+	}
+
+	// A capacity-1 ring has no usable slots (one is always reserved to
+	// distinguish full from empty), but pushing into it must not panic.
+	w := "b"
+	ring.PushAndWait(&w)
+
+	if got := ring.Len(); got != 0 {
+		t.Errorf("ringBuffer.Len() after Resize(0) and Push = %d, want 0", got)
+	}
+}
+
+func Test_NewUnbounded_invalidGrowthFactor_panics(t *testing.T) {
+	for _, growthFactor := range []float64{1, 0, -2, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewUnbounded(growthFactor=%v) did not panic, want panic", growthFactor)
+				}
+			}()
+			NewUnbounded[string](2, 1, growthFactor, 0)
+		}()
+	}
+}
@@ -0,0 +1,97 @@
+package ringbuffer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrSubscriptionClosed is returned by Subscription.Next once the subscription has
+// been closed.
+var ErrSubscriptionClosed = errors.New("ringbuffer: subscription closed")
+
+// ErrLagged is returned by Subscription.Next when elements the subscriber has not
+// yet read are no longer held by the ring, either because newer pushes overwrote
+// them or because they were popped. The subscription's cursor is fast-forwarded to
+// the oldest element still present in the ring, skipping Dropped elements.
+type ErrLagged struct {
+	Dropped uint64
+}
+
+func (e *ErrLagged) Error() string {
+	return fmt.Sprintf("ringbuffer: subscription lagged, dropped %d elements", e.Dropped)
+}
+
+// Subscription is an independent read cursor into a RingBuffer, obtained via
+// RingBuffer.Subscribe. Every element pushed to the ring becomes visible to every
+// live subscription; a subscription that falls behind has its cursor fast-forwarded
+// and observes an ErrLagged rather than silently missing elements.
+type Subscription[T any] struct {
+	ring   *RingBuffer[T]
+	cursor uint64
+	closed bool
+}
+
+// Subscribe returns a new Subscription positioned at the oldest element currently
+// held by the ring, so that the subscriber observes the existing backlog as well as
+// every subsequently pushed element.
+func (r *RingBuffer[T]) Subscribe() *Subscription[T] {
+	r.dataMx.Lock()
+	defer r.dataMx.Unlock()
+	return &Subscription[T]{
+		ring:   r,
+		cursor: r.pushSeq - uint64(r.Len()),
+	}
+}
+
+// Next blocks until the next element is available, the subscription is closed, ctx
+// is done, or ctx's deadline is exceeded. If elements the subscriber has not yet
+// read are no longer held by the ring, it returns an *ErrLagged instead of an
+// element, having fast-forwarded its cursor past the dropped elements.
+func (s *Subscription[T]) Next(ctx context.Context) (*T, error) {
+	r := s.ring
+	r.dataMx.Lock()
+	defer r.dataMx.Unlock()
+
+	stop := context.AfterFunc(ctx, func() {
+		r.dataMx.Lock()
+		r.notEmpty.Broadcast()
+		r.dataMx.Unlock()
+	})
+	defer stop()
+
+	for {
+		if s.closed {
+			return nil, ErrSubscriptionClosed
+		}
+
+		oldest := r.pushSeq - uint64(r.Len())
+		if s.cursor < oldest {
+			dropped := oldest - s.cursor
+			s.cursor = oldest
+			return nil, &ErrLagged{Dropped: dropped}
+		}
+
+		if s.cursor < r.pushSeq {
+			idx := (r.tail + uint(s.cursor-oldest)) % r.capacity
+			v := r.data[idx]
+			s.cursor++
+			return v, nil
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		r.notEmpty.Wait()
+	}
+}
+
+// Close stops the subscription; any Next call blocked on it, or any future call to
+// Next, returns ErrSubscriptionClosed.
+func (s *Subscription[T]) Close() {
+	r := s.ring
+	r.dataMx.Lock()
+	s.closed = true
+	r.notEmpty.Broadcast()
+	r.dataMx.Unlock()
+}
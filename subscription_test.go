@@ -0,0 +1,105 @@
+package ringbuffer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func Test_subscription_observesPushesAfterSubscribe(t *testing.T) {
+	ring := New[string](5, 1)
+	defer ring.Close()
+
+	sub := ring.Subscribe()
+	defer sub.Close()
+
+	v := "a"
+	ring.PushAndWait(&v)
+
+	got, err := sub.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Next() error = %v, want nil", err)
+	}
+	if got == nil || *got != v {
+		t.Errorf("Next() = %v, want %v", got, v)
+	}
+}
+
+func Test_subscription_observesExistingBacklog(t *testing.T) {
+	ring := New[string](5, 1)
+	defer ring.Close()
+
+	a, b := "a", "b"
+	ring.PushAndWait(&a, &b)
+
+	sub := ring.Subscribe()
+	defer sub.Close()
+
+	for _, want := range []string{a, b} {
+		got, err := sub.Next(context.Background())
+		if err != nil {
+			t.Fatalf("Next() error = %v, want nil", err)
+		}
+		if got == nil || *got != want {
+			t.Errorf("Next() = %v, want %v", got, want)
+		}
+	}
+}
+
+func Test_subscription_lagReportsDroppedCount(t *testing.T) {
+	ring := New[string](3, 1)
+	defer ring.Close()
+
+	sub := ring.Subscribe()
+	defer sub.Close()
+
+	values := []string{"a", "b", "c", "d", "e"}
+	pointerValueSlice := make([]*string, len(values))
+	for i, v := range values {
+		s := v
+		pointerValueSlice[i] = &s
+	}
+	ring.PushAndWait(pointerValueSlice...)
+
+	_, err := sub.Next(context.Background())
+	var lagged *ErrLagged
+	if !errors.As(err, &lagged) {
+		t.Fatalf("Next() error = %v, want *ErrLagged", err)
+	}
+	if lagged.Dropped == 0 {
+		t.Errorf("ErrLagged.Dropped = %d, want > 0", lagged.Dropped)
+	}
+
+	got, err := sub.Next(context.Background())
+	if err != nil {
+		t.Fatalf("Next() error = %v, want nil", err)
+	}
+	if got == nil || *got != "d" {
+		t.Errorf("Next() = %v, want d", got)
+	}
+}
+
+func Test_subscription_closeUnblocksNext(t *testing.T) {
+	ring := New[string](3, 1)
+	defer ring.Close()
+
+	sub := ring.Subscribe()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := sub.Next(context.Background())
+		done <- err
+	}()
+
+	sub.Close()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrSubscriptionClosed) {
+			t.Errorf("Next() error = %v, want %v", err, ErrSubscriptionClosed)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Next() did not unblock after Close()")
+	}
+}